@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
+
 	kahubk "github.com/soda-cdm/kahu/apis/kahu/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -39,6 +41,27 @@ const (
 	// ReplaceConcurrent ConcurrencyPolicy = "Replace"
 )
 
+// DefaultScheduleController is the reserved ManagedBy value identifying the in-tree
+// schedule reconciler. Any other non-empty value hands scheduling decisions off to an
+// external controller, eg "kueue.x-k8s.io/multikueue".
+const DefaultScheduleController = "kahu.io/schedule-controller"
+
+// MissedRunPolicy describes how the controller catches up on scheduled runs that were
+// missed, eg due to controller downtime or the cluster being paused.
+type MissedRunPolicy string
+
+const (
+	// MissedRunPolicySkip drops all missed runs and waits for the next regular run.
+	MissedRunPolicySkip MissedRunPolicy = "Skip"
+
+	// MissedRunPolicyRunOnce triggers a single backfill run covering all missed runs.
+	MissedRunPolicyRunOnce MissedRunPolicy = "RunOnce"
+
+	// MissedRunPolicyRunAll triggers one backfill run per missed run, honoring
+	// StartingDeadlineSeconds for how far back to go.
+	MissedRunPolicyRunAll MissedRunPolicy = "RunAll"
+)
+
 // ReclaimPolicy tells about reclamation of the backup. It can be either delete or retain
 type BackupScheduleReclaimPolicyType struct {
 	// +optional
@@ -48,6 +71,56 @@ type BackupScheduleReclaimPolicyType struct {
 	ReclaimPolicyRetain string `json:"reclaimPolicyRetain,omitempty"`
 }
 
+// RetentionPolicy is a tiered, restic/k8up style Grandfather-Father-Son retention scheme.
+// On each successful run, the reaper buckets existing backups by
+// (year, month, ISO-week, day-of-year, hour) using their CreationTimestamp, keeps the
+// newest one per bucket up to each limit below, plus always keeps the newest KeepLast,
+// and deletes the rest honoring ReclaimPolicy. A nil field means unlimited for that tier.
+type RetentionPolicy struct {
+	// KeepLast is the number of most recent backups to always keep, regardless of age.
+	// +optional
+	KeepLast *int `json:"keepLast,omitempty"`
+	// KeepHourly is the number of most recent hourly buckets to keep one backup from.
+	// +optional
+	KeepHourly *int `json:"keepHourly,omitempty"`
+	// KeepDaily is the number of most recent daily buckets to keep one backup from.
+	// +optional
+	KeepDaily *int `json:"keepDaily,omitempty"`
+	// KeepWeekly is the number of most recent ISO-week buckets to keep one backup from.
+	// +optional
+	KeepWeekly *int `json:"keepWeekly,omitempty"`
+	// KeepMonthly is the number of most recent monthly buckets to keep one backup from.
+	// +optional
+	KeepMonthly *int `json:"keepMonthly,omitempty"`
+	// KeepYearly is the number of most recent yearly buckets to keep one backup from.
+	// +optional
+	KeepYearly *int `json:"keepYearly,omitempty"`
+	// KeepTags is a list of label selectors; backups matching any of them are never pruned.
+	// +optional
+	KeepTags []string `json:"keepTags,omitempty"`
+}
+
+// validates the RetentionPolicy
+func (r *RetentionPolicy) Validate() error {
+	tiers := []struct {
+		name  string
+		value *int
+	}{
+		{"keepLast", r.KeepLast},
+		{"keepHourly", r.KeepHourly},
+		{"keepDaily", r.KeepDaily},
+		{"keepWeekly", r.KeepWeekly},
+		{"keepMonthly", r.KeepMonthly},
+		{"keepYearly", r.KeepYearly},
+	}
+	for _, tier := range tiers {
+		if tier.value != nil && *tier.value < 0 {
+			return fmt.Errorf("%s must not be negative, got %d", tier.name, *tier.value)
+		}
+	}
+	return nil
+}
+
 type BackupScheduleSpec struct {
 	// optional, name of the SchedulePolicy CR
 	// if empty considered as manual trigger otherwise scheduled based backup will be taken
@@ -76,10 +149,71 @@ type BackupScheduleSpec struct {
 	// - "Forbid"(default): forbids concurrent runs, skipping next run if previous run hasn't finished yet.
 	// +optional
 	ConcurrentPolicy ConcurrencyPolicy `json:"concurrentPolicy,omitempty"`
+	// Pause tells the controller to stop triggering new backups while preserving the
+	// current state and next-run tracking, unlike Enable=false which stops permanently
+	// and resets scheduling. When Pause is unset or false, scheduling resumes as normal.
+	// +optional
+	Pause *bool `json:"pause,omitempty"`
+	// SuccessfulJobsHistoryLimit is the number of successful completed backups to retain,
+	// independent of FailedJobsHistoryLimit. Replaces the fixed "latest 10" retention of
+	// RecentStatusInfo. nil means the default of 10 is used.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+	// FailedJobsHistoryLimit is the number of failed completed backups to retain,
+	// independent of SuccessfulJobsHistoryLimit. nil means the default of 10 is used.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+	// RetentionPolicy is a tiered GFS retention scheme the reaper applies to backups taken
+	// by this schedule. This is the only retention knob for schedule-driven backups; the
+	// SchedulePolicy sub-types no longer carry a per-frequency MaxCopies cap.
+	// +optional
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
+	// ManagedBy is empty (defaults to the in-tree reconciler), DefaultScheduleController,
+	// or an external controller identifier. When set to a non-empty, non-default value the
+	// in-tree controller skips reconciliation entirely and only observes status. Immutable
+	// once set; enforced on update via ValidateManagedByUpdate since this module has no
+	// validating webhook of its own.
+	// +optional
+	ManagedBy *string `json:"managedBy,omitempty"`
+	// MissedRunPolicy controls how runs missed during controller downtime or a paused
+	// cluster are backfilled.
+	// +kubebuilder:default=Skip
+	// +kubebuilder:validation:Optional
+	MissedRunPolicy MissedRunPolicy `json:"missedRunPolicy,omitempty"`
 	// this Backup spec
 	BackupTemplate kahubk.BackupSpec `json:"template,omitempty"`
 }
 
+// Validate validates the BackupScheduleSpec's RetentionPolicy, when set.
+func (s *BackupScheduleSpec) Validate() error {
+	if s.RetentionPolicy != nil {
+		return s.RetentionPolicy.Validate()
+	}
+	return nil
+}
+
+// IsManagedByDefaultController reports whether the in-tree schedule reconciler should
+// handle this BackupSchedule, ie ManagedBy is unset or explicitly DefaultScheduleController.
+func (s *BackupScheduleSpec) IsManagedByDefaultController() bool {
+	return s.ManagedBy == nil || *s.ManagedBy == "" || *s.ManagedBy == DefaultScheduleController
+}
+
+// ValidateManagedByUpdate enforces that ManagedBy is immutable once set: if old already
+// has a non-empty ManagedBy, the updated spec must carry the same value unchanged.
+// Callers invoke this on update (eg from a validating webhook or an update strategy)
+// since this module does not register its own webhook.
+func (s *BackupScheduleSpec) ValidateManagedByUpdate(old *BackupScheduleSpec) error {
+	if old.ManagedBy == nil || *old.ManagedBy == "" {
+		return nil
+	}
+	if s.ManagedBy == nil || *s.ManagedBy != *old.ManagedBy {
+		return fmt.Errorf("managedBy is immutable once set: got %v, want %q", s.ManagedBy, *old.ManagedBy)
+	}
+	return nil
+}
+
 type ScheduleStatus string
 type ExecutionStatus string
 
@@ -89,6 +223,7 @@ const (
 	ScheduleInActive ScheduleStatus = "InActive"
 	ScheduleFailed   ScheduleStatus = "Failed"
 	ScheduleDeleting ScheduleStatus = "Deleting"
+	SchedulePaused   ScheduleStatus = "Paused"
 
 	ExecutionSuccess    ExecutionStatus = "Success"
 	ExecutionInProgress ExecutionStatus = "InProgress"
@@ -103,7 +238,8 @@ type StatusInfo struct {
 }
 
 type BackupScheduleStatus struct {
-	// latest 10 scheduled backup status is stored
+	// scheduled backup status retained, bounded by SuccessfulJobsHistoryLimit and
+	// FailedJobsHistoryLimit (defaults to latest 10 of each when unset)
 	RecentStatusInfo    []StatusInfo    `json:"recentStatusInfo"`
 	LastBackupName      string          `json:"lastBackupName"`
 	LastExecutionStatus ExecutionStatus `json:"lastExecutionStatus"`
@@ -114,6 +250,19 @@ type BackupScheduleStatus struct {
 	SchedStatus             ScheduleStatus `json:"schedStatus"`
 	// the created backup crd status used to identify the completed or not
 	BackupStatus kahubk.BackupState `json:"backupStatus"`
+	// NextScheduleTime is the next time the schedule is expected to trigger a backup
+	// +optional
+	NextScheduleTime metav1.Time `json:"nextScheduleTime,omitempty"`
+	// LastSuccessfulBackupTime is the completion time of the most recent successful backup
+	// +optional
+	LastSuccessfulBackupTime metav1.Time `json:"lastSuccessfulBackupTime,omitempty"`
+	// MissedSchedules counts the scheduled runs that were missed and handled per
+	// MissedRunPolicy
+	// +optional
+	MissedSchedules int32 `json:"missedSchedules,omitempty"`
+	// LastMissedTime is the scheduled time of the most recently missed run
+	// +optional
+	LastMissedTime metav1.Time `json:"lastMissedTime,omitempty"`
 }
 
 // +genclient
@@ -125,6 +274,8 @@ type BackupScheduleStatus struct {
 // +kubebuilder:printcolumn:name="LastBackupName",type=string,JSONPath=`.status.lastBackupName`,description="Name of the recent backup triggered based on this backupschedule."
 // +kubebuilder:printcolumn:name="BackupPolicyName",type=string,JSONPath=`.spec.backupPolicyName`,description=" schedule policy Name."
 // +kubebuilder:printcolumn:name="Enable",type=boolean,JSONPath=`.spec.enable`,description="Indicates the backup trigger is enabled or disabled."
+// +kubebuilder:printcolumn:name="Paused",type=boolean,JSONPath=`.spec.pause`,description="Indicates the backup trigger is temporarily paused."
+// +kubebuilder:printcolumn:name="NextScheduleTime",type=date,JSONPath=`.status.nextScheduleTime`,description="Next time a backup is expected to be triggered."
 type BackupSchedule struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The SODA Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// NOTE: as with the other +genclient / +k8s:deepcopy-gen types in this package, the
+// DeepCopyObject implementations and the generated clientset/listers/informers for
+// RestoreSchedule and RestoreScheduleList are produced by the out-of-tree codegen
+// tooling (hack/update-codegen.sh) and are not checked into this module snapshot.
+
+import (
+	kahubk "github.com/soda-cdm/kahu/apis/kahu/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type RestoreScheduleSpec struct {
+	// optional, name of the SchedulePolicy CR
+	// if empty considered as manual trigger otherwise scheduled based restore will be taken
+	RestorePolicyName string `json:"restorePolicyName"`
+	// Enable tells whether Scheduled Restore should be started or stopped
+	// +optional
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	Enable bool `json:"enable,omitempty"`
+	// +kubebuilder:validation:Maximum=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Optional
+	MaxRetriesOnFailure int `json:"maxRetriesOnFailure"`
+	// Optional deadline in seconds for starting the Restore if it misses
+	// scheduled time for any reason.
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+	// Specifies how to treat concurrent executions of a Restore.
+	// Valid values are:
+	// - "Allow": allows Restores to run concurrently;
+	// - "Forbid"(default): forbids concurrent runs, skipping next run if previous run hasn't finished yet.
+	// +optional
+	ConcurrentPolicy ConcurrencyPolicy `json:"concurrentPolicy,omitempty"`
+	// VerifyOnly, when true, runs this as a scratch restore that is dropped once completed,
+	// serving only to continuously verify backup integrity rather than produce a usable restore.
+	// +optional
+	// +kubebuilder:default=false
+	VerifyOnly bool `json:"verifyOnly,omitempty"`
+	// TargetNamespace is the namespace the restore (or verification restore) is performed into.
+	// +optional
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+	// TargetCluster is the cluster the restore (or verification restore) is performed into.
+	// +optional
+	TargetCluster string `json:"targetCluster,omitempty"`
+	// this Restore spec
+	RestoreTemplate kahubk.RestoreSpec `json:"template,omitempty"`
+}
+
+type RestoreScheduleStatus struct {
+	// latest scheduled restore status is stored
+	RecentStatusInfo    []StatusInfo    `json:"recentStatusInfo"`
+	LastRestoreName     string          `json:"lastRestoreName"`
+	LastExecutionStatus ExecutionStatus `json:"lastExecutionStatus"`
+	// LastStartTimestamp defines time when Schedule created the restore
+	LastStartTimestamp metav1.Time `json:"lastStartTimestamp"`
+	// LastCompletionTimestamp defines time when restore completed
+	LastCompletionTimestamp metav1.Time    `json:"lastCompletionTimestamp"`
+	SchedStatus             ScheduleStatus `json:"schedStatus"`
+	// the created restore crd status used to identify the completed or not
+	RestoreStatus kahubk.RestoreState `json:"restoreStatus"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:subresource:status
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="LastRestoreName",type=string,JSONPath=`.status.lastRestoreName`,description="Name of the recent restore triggered based on this restoreschedule."
+// +kubebuilder:printcolumn:name="RestorePolicyName",type=string,JSONPath=`.spec.restorePolicyName`,description=" schedule policy Name."
+// +kubebuilder:printcolumn:name="Enable",type=boolean,JSONPath=`.spec.enable`,description="Indicates the restore trigger is enabled or disabled."
+// +kubebuilder:printcolumn:name="VerifyOnly",type=boolean,JSONPath=`.spec.verifyOnly`,description="Indicates this schedule only runs scratch restores for backup verification."
+type RestoreSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RestoreScheduleSpec   `json:"spec,omitempty"`
+	Status            RestoreScheduleStatus `json:"status,omitempty"`
+}
+
+// RestoreScheduleList contains a List of RestoreSchedule
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RestoreScheduleList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Items             []RestoreSchedule `json:"items"`
+}
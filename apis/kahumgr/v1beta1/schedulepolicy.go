@@ -18,8 +18,10 @@ package v1beta1
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/robfig/cron/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -41,20 +43,27 @@ var DaysType = map[string]time.Weekday{
 }
 
 const (
-	layoutTime               = "15:09"
+	layoutTime               = "15:04"
 	HourlyPolicyType  string = "Hourly"
 	DailyPolicyType   string = "Daily"
 	WeeklyPolicyType  string = "Weekly"
 	MonthlyPolicyType string = "Monthly"
+	CronPolicyType    string = "Cron"
 )
 
-func checkTimeFormat(policyTime string) error {
-	_, err := time.Parse(layoutTime, policyTime)
+// parseHourMinute parses and validates a "HH:MM" policyTime, returning its hour and minute.
+func parseHourMinute(policyTime string) (int, int, error) {
+	t, err := time.Parse(layoutTime, policyTime)
 	if err != nil {
-		return fmt.Errorf("policyTime is: %s, err format :%v, you should provide the time"+
+		return 0, 0, fmt.Errorf("policyTime is: %s, err format :%v, you should provide the time"+
 			" in the 0:00-23-59 format", policyTime, err)
 	}
-	return nil
+	return t.Hour(), t.Minute(), nil
+}
+
+func checkTimeFormat(policyTime string) error {
+	_, _, err := parseHourMinute(policyTime)
+	return err
 }
 
 // for every number of minutes after every hour the schedule will be triggered
@@ -65,11 +74,26 @@ type HourlyPolicy struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Required
 	Minutes int `json:"minutes"`
-	// +kubebuilder:validation:Maximum=256
-	// +kubebuilder:validation:Minimum=1
-	// +kubebuilder:default=24
-	// +kubebuilder:validation:Optional
-	MaxCopies int `json:"maxCopies"`
+	// Optional deadline in seconds for starting the run if it misses its scheduled time
+	// for any reason.
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+}
+
+// NextN returns the next n scheduled fire times strictly after from, in the policy's
+// local wall-clock rules, so callers can reason about expected fire times without
+// duplicating date arithmetic.
+func (h *HourlyPolicy) NextN(from time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+	next := time.Date(from.Year(), from.Month(), from.Day(), from.Hour(), h.Minutes, 0, 0, from.Location())
+	if !next.After(from) {
+		next = next.Add(time.Hour)
+	}
+	for len(times) < n {
+		times = append(times, next)
+		next = next.Add(time.Hour)
+	}
+	return times
 }
 
 // Daily Policy contains the time in the day when the action should be triggered
@@ -79,11 +103,10 @@ type DailyPolicy struct {
 	// time eg 12:15
 	// +kubebuilder:validation:Required
 	Time string `json:"time"`
-	// +kubebuilder:validation:Maximum=256
-	// +kubebuilder:validation:Minimum=1
-	// +kubebuilder:default=15
-	// +kubebuilder:validation:Optional
-	MaxCopies int `json:"maxCopies"`
+	// Optional deadline in seconds for starting the run if it misses its scheduled time
+	// for any reason.
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
 }
 
 func (d *DailyPolicy) CheckTimeFormat() error {
@@ -95,6 +118,24 @@ func (d *DailyPolicy) Validate() error {
 	return d.CheckTimeFormat()
 }
 
+// NextN returns the next n scheduled fire times strictly after from.
+func (d *DailyPolicy) NextN(from time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+	hour, min, err := parseHourMinute(d.Time)
+	if err != nil {
+		return times
+	}
+	next := time.Date(from.Year(), from.Month(), from.Day(), hour, min, 0, 0, from.Location())
+	if !next.After(from) {
+		next = next.AddDate(0, 0, 1)
+	}
+	for len(times) < n {
+		times = append(times, next)
+		next = next.AddDate(0, 0, 1)
+	}
+	return times
+}
+
 // Weekly Policy contains the days and time  in a week when the action should be triggered
 // the cron example  25 11 ? * (1,2) (so on Mon,Tues at 11:25 Hrs triggers)
 type WeeklyPolicy struct {
@@ -104,11 +145,10 @@ type WeeklyPolicy struct {
 	Days []string `json:"days"`
 	// +kubebuilder:validation:Required
 	Time string `json:"time"`
-	// +kubebuilder:validation:Maximum=256
-	// +kubebuilder:validation:Minimum=1
-	// +kubebuilder:default=4
-	// +kubebuilder:validation:Optional
-	MaxCopies int `json:"maxCopies"`
+	// Optional deadline in seconds for starting the run if it misses its scheduled time
+	// for any reason.
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
 }
 
 func (w *WeeklyPolicy) CheckTimeFormat() error {
@@ -149,6 +189,34 @@ func (w *WeeklyPolicy) Validate() error {
 	return nil
 }
 
+// NextN returns the next n scheduled fire times strictly after from, scanning day by day
+// for a matching weekday.
+func (w *WeeklyPolicy) NextN(from time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+	if len(w.Days) == 0 {
+		return times
+	}
+	hour, min, err := parseHourMinute(w.Time)
+	if err != nil {
+		return times
+	}
+	weekdays := make(map[time.Weekday]bool)
+	for _, day := range w.Days {
+		weekdays[DaysType[day]] = true
+	}
+	day := time.Date(from.Year(), from.Month(), from.Day(), hour, min, 0, 0, from.Location())
+	if !day.After(from) {
+		day = day.AddDate(0, 0, 1)
+	}
+	for len(times) < n {
+		if weekdays[day.Weekday()] {
+			times = append(times, day)
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return times
+}
+
 // Monthly Policy contains the dates and time  in a month when the action should be triggered
 // the cron example  25 11 (1,5,8,11,18) * ?
 // (so on given dates every month at 11:25 Hrs triggers)
@@ -160,11 +228,10 @@ type MonthlyPolicy struct {
 	// eg 12:15
 	// +kubebuilder:validation:Required
 	Time string `json:"time"`
-	// +kubebuilder:validation:Maximum=256
-	// +kubebuilder:validation:Minimum=1
-	// +kubebuilder:default=12
-	// +kubebuilder:validation:Optional
-	MaxCopies int `json:"maxCopies"`
+	// Optional deadline in seconds for starting the run if it misses its scheduled time
+	// for any reason.
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
 }
 
 func (m *MonthlyPolicy) CheckTimeFormat() error {
@@ -207,12 +274,165 @@ func (m *MonthlyPolicy) Validate() error {
 	return nil
 }
 
+// NextN returns the next n scheduled fire times strictly after from. Dates beyond the
+// number of days in a given month roll over into the following month by the excess
+// days, eg 31 falls on 3 March in a 28 day February and on 2 March in a leap year.
+func (m *MonthlyPolicy) NextN(from time.Time, n int) []time.Time {
+	if len(m.Dates) == 0 {
+		return []time.Time{}
+	}
+	hour, min, err := parseHourMinute(m.Time)
+	if err != nil {
+		return []time.Time{}
+	}
+	var times []time.Time
+	// a rolled-over date lands no later than the 3rd of the next month, so the month before
+	// from's can still fire after from; every month after from's adds at least one new
+	// time, so months -1..n+1 hold the first n times in full.
+	for offset := -1; offset <= n+1; offset++ {
+		year, month := from.Year(), int(from.Month())+offset
+		for _, date := range m.Dates {
+			t := time.Date(year, time.Month(month), date, hour, min, 0, 0, from.Location())
+			if t.After(from) {
+				times = append(times, t)
+			}
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	// a rolled-over date (eg 31 in a 30 day month) can land on the same instant as an
+	// explicit date in the following month; dedupe before truncating to n.
+	deduped := times[:0]
+	for i, t := range times {
+		if i == 0 || !t.Equal(deduped[len(deduped)-1]) {
+			deduped = append(deduped, t)
+		}
+	}
+	times = deduped
+	if len(times) > n {
+		times = times[:n]
+	}
+	return times
+}
+
+// Cron Policy allows an arbitrary cadence to be expressed as a standard cron
+// expression, for cases the fixed Hourly/Daily/Weekly/Monthly buckets cannot cover
+// (every 15 minutes, weekdays 9-17, quarterly, etc).
+type CronPolicy struct {
+	// Expression is a standard 5 field cron expression, eg "*/15 * * * *"
+	// +kubebuilder:validation:Required
+	Expression string `json:"expression"`
+	// TimeZone is the IANA time zone the Expression is evaluated in, eg "Asia/Kolkata".
+	// Defaults to UTC when empty.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// validates the CronPolicy
+func (c *CronPolicy) Validate() error {
+	if _, err := cron.ParseStandard(c.Expression); err != nil {
+		return fmt.Errorf("invalid cron expression: %s, err: %v", c.Expression, err)
+	}
+	if c.TimeZone != "" {
+		if _, err := time.LoadLocation(c.TimeZone); err != nil {
+			return fmt.Errorf("invalid timeZone: %s, err: %v", c.TimeZone, err)
+		}
+	}
+	return nil
+}
+
+// NextN returns the next n scheduled fire times strictly after from, evaluated in the
+// policy's TimeZone (UTC when empty).
+func (c *CronPolicy) NextN(from time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+	schedule, err := cron.ParseStandard(c.Expression)
+	if err != nil {
+		return times
+	}
+	loc := time.UTC
+	if c.TimeZone != "" {
+		if l, err := time.LoadLocation(c.TimeZone); err == nil {
+			loc = l
+		}
+	}
+	next := from.In(loc)
+	for len(times) < n {
+		next = schedule.Next(next)
+		times = append(times, next)
+	}
+	return times
+}
+
 // SchedulePolicyspec
 type SchedulePolicySpec struct {
 	Hourly  *HourlyPolicy  `json:"hourly,omitempty"`
 	Daily   *DailyPolicy   `json:"daily,omitempty"`
 	Weekly  *WeeklyPolicy  `json:"weekly,omitempty"`
 	Monthly *MonthlyPolicy `json:"monthly,omitempty"`
+	Cron    *CronPolicy    `json:"cron,omitempty"`
+	// ManagedBy is empty (defaults to the in-tree reconciler), DefaultScheduleController,
+	// or an external controller identifier. When set to a non-empty, non-default value the
+	// in-tree controller skips reconciliation entirely and only observes status. Immutable
+	// once set; enforced on update via ValidateManagedByUpdate since this module has no
+	// validating webhook of its own.
+	// +optional
+	ManagedBy *string `json:"managedBy,omitempty"`
+}
+
+// IsManagedByDefaultController reports whether the in-tree schedule reconciler should
+// handle this SchedulePolicy, ie ManagedBy is unset or explicitly DefaultScheduleController.
+func (s *SchedulePolicySpec) IsManagedByDefaultController() bool {
+	return s.ManagedBy == nil || *s.ManagedBy == "" || *s.ManagedBy == DefaultScheduleController
+}
+
+// ValidateManagedByUpdate enforces that ManagedBy is immutable once set: if old already
+// has a non-empty ManagedBy, the updated spec must carry the same value unchanged.
+// Callers invoke this on update (eg from a validating webhook or an update strategy)
+// since this module does not register its own webhook.
+func (s *SchedulePolicySpec) ValidateManagedByUpdate(old *SchedulePolicySpec) error {
+	if old.ManagedBy == nil || *old.ManagedBy == "" {
+		return nil
+	}
+	if s.ManagedBy == nil || *s.ManagedBy != *old.ManagedBy {
+		return fmt.Errorf("managedBy is immutable once set: got %v, want %q", s.ManagedBy, *old.ManagedBy)
+	}
+	return nil
+}
+
+// Validate ensures exactly one of Hourly/Daily/Weekly/Monthly/Cron is set and
+// delegates to the selected policy's own Validate.
+func (s *SchedulePolicySpec) Validate() error {
+	set := 0
+	if s.Hourly != nil {
+		set++
+	}
+	if s.Daily != nil {
+		set++
+	}
+	if s.Weekly != nil {
+		set++
+	}
+	if s.Monthly != nil {
+		set++
+	}
+	if s.Cron != nil {
+		set++
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one of hourly, daily, weekly, monthly or cron must be set, got %d", set)
+	}
+	if s.Daily != nil {
+		return s.Daily.Validate()
+	}
+	if s.Weekly != nil {
+		return s.Weekly.Validate()
+	}
+	if s.Monthly != nil {
+		return s.Monthly.Validate()
+	}
+	if s.Cron != nil {
+		return s.Cron.Validate()
+	}
+	return nil
 }
 
 // SchedulePolicy is the Schema for the policy API